@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,13 +20,37 @@ func dataSourceAwsElastiCacheCluster() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				StateFunc: func(v interface{}) string {
 					value := v.(string)
 					return strings.ToLower(value)
 				},
 			},
 
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"node_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -43,6 +68,7 @@ func dataSourceAwsElastiCacheCluster() *schema.Resource {
 
 			"engine": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 
@@ -58,6 +84,7 @@ func dataSourceAwsElastiCacheCluster() *schema.Resource {
 
 			"replication_group_id": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 
@@ -100,6 +127,60 @@ func dataSourceAwsElastiCacheCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"notification_topic_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"auth_token_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"transit_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"at_rest_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"auto_minor_version_upgrade": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"log_delivery_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"port": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -145,7 +226,7 @@ func dataSourceAwsElastiCacheCluster() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchemaComputed(),
+			"tags": tagsSchema(),
 		},
 	}
 }
@@ -153,26 +234,65 @@ func dataSourceAwsElastiCacheCluster() *schema.Resource {
 func dataSourceAwsElastiCacheClusterRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 
-	req := &elasticache.DescribeCacheClustersInput{
-		CacheClusterId:    aws.String(d.Get("cluster_id").(string)),
-		ShowCacheNodeInfo: aws.Bool(true),
+	var clusters []*elasticache.CacheCluster
+
+	if v, ok := d.GetOk("cluster_id"); ok {
+		req := &elasticache.DescribeCacheClustersInput{
+			CacheClusterId:    aws.String(v.(string)),
+			ShowCacheNodeInfo: aws.Bool(true),
+		}
+
+		log.Printf("[DEBUG] Reading ElastiCache Cluster: %s", req)
+		resp, err := conn.DescribeCacheClusters(req)
+		if err != nil {
+			return err
+		}
+
+		clusters = resp.CacheClusters
+	} else {
+		req := &elasticache.DescribeCacheClustersInput{
+			ShowCacheNodeInfo: aws.Bool(true),
+			MaxRecords:        aws.Int64(100),
+		}
+
+		log.Printf("[DEBUG] Reading ElastiCache Clusters: %s", req)
+		for {
+			resp, err := conn.DescribeCacheClusters(req)
+			if err != nil {
+				return err
+			}
+
+			clusters = append(clusters, resp.CacheClusters...)
+
+			if resp.Marker == nil || aws.StringValue(resp.Marker) == "" {
+				break
+			}
+			req.Marker = resp.Marker
+		}
 	}
 
-	log.Printf("[DEBUG] Reading ElastiCache Cluster: %s", req)
-	resp, err := conn.DescribeCacheClusters(req)
+	clusters, err := filterElastiCacheClusters(d, conn, meta, clusters)
 	if err != nil {
 		return err
 	}
 
-	if len(resp.CacheClusters) < 1 {
+	sort.Slice(clusters, func(i, j int) bool {
+		return aws.StringValue(clusters[i].CacheClusterId) < aws.StringValue(clusters[j].CacheClusterId)
+	})
+
+	var cluster *elasticache.CacheCluster
+	switch {
+	case len(clusters) < 1:
 		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
-	}
-	if len(resp.CacheClusters) > 1 {
-		return fmt.Errorf("Your query returned more than one result. Please try a more specific search criteria.")
+	case len(clusters) > 1:
+		if !d.Get("most_recent").(bool) {
+			return fmt.Errorf("Your query returned more than one result. Please try a more specific search criteria, or set `most_recent` to true.")
+		}
+		cluster = mostRecentElastiCacheCluster(clusters)
+	default:
+		cluster = clusters[0]
 	}
 
-	cluster := resp.CacheClusters[0]
-
 	d.SetId(*cluster.CacheClusterId)
 
 	d.Set("cluster_id", cluster.CacheClusterId)
@@ -196,11 +316,18 @@ func dataSourceAwsElastiCacheClusterRead(d *schema.ResourceData, meta interface{
 	d.Set("snapshot_window", cluster.SnapshotWindow)
 	d.Set("snapshot_retention_limit", cluster.SnapshotRetentionLimit)
 	d.Set("availability_zone", cluster.PreferredAvailabilityZone)
+	d.Set("auth_token_enabled", cluster.AuthTokenEnabled)
+	d.Set("transit_encryption_enabled", cluster.TransitEncryptionEnabled)
+	d.Set("at_rest_encryption_enabled", cluster.AtRestEncryptionEnabled)
+	d.Set("auto_minor_version_upgrade", cluster.AutoMinorVersionUpgrade)
 
 	if cluster.NotificationConfiguration != nil {
-		if *cluster.NotificationConfiguration.TopicStatus == "active" {
-			d.Set("notification_topic_arn", cluster.NotificationConfiguration.TopicArn)
-		}
+		d.Set("notification_topic_arn", cluster.NotificationConfiguration.TopicArn)
+		d.Set("notification_topic_status", cluster.NotificationConfiguration.TopicStatus)
+	}
+
+	if err := d.Set("log_delivery_configuration", flattenElastiCacheLogDeliveryConfigurations(cluster.LogDeliveryConfigurations)); err != nil {
+		return fmt.Errorf("error setting log_delivery_configuration: %s", err)
 	}
 
 	if cluster.ConfigurationEndpoint != nil {
@@ -235,3 +362,134 @@ func dataSourceAwsElastiCacheClusterRead(d *schema.ResourceData, meta interface{
 	return nil
 
 }
+
+// elastiCacheClusterFilterNameList is the set of `filter.name` values accepted by
+// filterElastiCacheClusters. Keep elastiCacheClusterFilterNames in sync.
+var elastiCacheClusterFilterNameList = []string{"engine", "replication-group-id", "cluster-id"}
+
+var elastiCacheClusterFilterNames = map[string]struct{}{
+	"engine":               {},
+	"replication-group-id": {},
+	"cluster-id":           {},
+}
+
+// filterElastiCacheClusters narrows a list of clusters down to those matching the
+// `engine`, `replication_group_id`, `filter`, and `tags` arguments, so that callers
+// can look clusters up by attributes instead of requiring an exact `cluster_id`.
+func filterElastiCacheClusters(d *schema.ResourceData, conn *elasticache.ElastiCache, meta interface{}, clusters []*elasticache.CacheCluster) ([]*elasticache.CacheCluster, error) {
+	engine, hasEngine := d.GetOk("engine")
+	replicationGroupID, hasReplicationGroupID := d.GetOk("replication_group_id")
+
+	filters := map[string][]string{}
+	if v, ok := d.GetOk("filter"); ok {
+		for _, raw := range v.(*schema.Set).List() {
+			f := raw.(map[string]interface{})
+			name := f["name"].(string)
+			if _, ok := elastiCacheClusterFilterNames[name]; !ok {
+				return nil, fmt.Errorf("invalid filter name %q, supported names are: %s", name, strings.Join(elastiCacheClusterFilterNameList, ", "))
+			}
+			for _, value := range f["values"].([]interface{}) {
+				filters[name] = append(filters[name], value.(string))
+			}
+		}
+	}
+
+	tagsInput, hasTags := d.GetOk("tags")
+
+	var result []*elasticache.CacheCluster
+	for _, cluster := range clusters {
+		if hasEngine && aws.StringValue(cluster.Engine) != engine.(string) {
+			continue
+		}
+
+		if hasReplicationGroupID && aws.StringValue(cluster.ReplicationGroupId) != replicationGroupID.(string) {
+			continue
+		}
+
+		if values, ok := filters["engine"]; ok && !sliceContainsString(values, aws.StringValue(cluster.Engine)) {
+			continue
+		}
+
+		if values, ok := filters["replication-group-id"]; ok && !sliceContainsString(values, aws.StringValue(cluster.ReplicationGroupId)) {
+			continue
+		}
+
+		if values, ok := filters["cluster-id"]; ok && !sliceContainsString(values, aws.StringValue(cluster.CacheClusterId)) {
+			continue
+		}
+
+		if hasTags {
+			arn := arn.ARN{
+				Partition: meta.(*AWSClient).partition,
+				Service:   "elasticache",
+				Region:    meta.(*AWSClient).region,
+				AccountID: meta.(*AWSClient).accountid,
+				Resource:  fmt.Sprintf("cluster:%s", aws.StringValue(cluster.CacheClusterId)),
+			}.String()
+
+			clusterTags, err := keyvaluetags.ElasticacheListTags(conn, arn)
+			if err != nil {
+				return nil, fmt.Errorf("error listing tags for Elasticache Cluster (%s): %s", arn, err)
+			}
+
+			if !clusterTags.IgnoreAws().ContainsAll(keyvaluetags.New(tagsInput.(map[string]interface{}))) {
+				continue
+			}
+		}
+
+		result = append(result, cluster)
+	}
+
+	return result, nil
+}
+
+func flattenElastiCacheLogDeliveryConfigurations(configs []*elasticache.LogDeliveryConfiguration) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(configs))
+
+	for _, config := range configs {
+		if config == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"destination_type": aws.StringValue(config.DestinationType),
+			"log_format":       aws.StringValue(config.LogFormat),
+			"log_type":         aws.StringValue(config.LogType),
+			"status":           aws.StringValue(config.Status),
+		}
+
+		if config.DestinationDetails != nil {
+			if config.DestinationDetails.CloudWatchLogsDetails != nil {
+				m["destination"] = aws.StringValue(config.DestinationDetails.CloudWatchLogsDetails.LogGroup)
+			} else if config.DestinationDetails.KinesisFirehoseDetails != nil {
+				m["destination"] = aws.StringValue(config.DestinationDetails.KinesisFirehoseDetails.DeliveryStream)
+			}
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func sliceContainsString(slice []string, s string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func mostRecentElastiCacheCluster(clusters []*elasticache.CacheCluster) *elasticache.CacheCluster {
+	mostRecent := clusters[0]
+	for _, cluster := range clusters[1:] {
+		if cluster.CacheClusterCreateTime == nil {
+			continue
+		}
+		if mostRecent.CacheClusterCreateTime == nil || cluster.CacheClusterCreateTime.After(*mostRecent.CacheClusterCreateTime) {
+			mostRecent = cluster
+		}
+	}
+	return mostRecent
+}
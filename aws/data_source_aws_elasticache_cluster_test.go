@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAwsElastiCacheCluster_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_elasticache_cluster.test"
+	resourceName := "aws_elasticache_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsElastiCacheClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_id", resourceName, "cluster_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "engine", resourceName, "engine"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsElastiCacheCluster_filter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_elasticache_cluster.by_filter"
+	resourceName := "aws_elasticache_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsElastiCacheClusterConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_id", resourceName, "cluster_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsElastiCacheCluster_encryptionAndLogDelivery(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_elasticache_cluster.test"
+	resourceName := "aws_elasticache_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsElastiCacheClusterConfig_encryptionAndLogDelivery(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "at_rest_encryption_enabled", resourceName, "at_rest_encryption_enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_encryption_enabled", resourceName, "transit_encryption_enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_minor_version_upgrade", resourceName, "auto_minor_version_upgrade"),
+					resource.TestCheckResourceAttr(dataSourceName, "log_delivery_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "log_delivery_configuration.0.log_type", "slow-log"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsElastiCacheCluster_filterInvalidName(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAwsElastiCacheClusterConfig_filterInvalidName(rName),
+				ExpectError: regexp.MustCompile(`invalid filter name "node-type"`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsElastiCacheClusterConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_cluster" "test" {
+  cluster_id      = %[1]q
+  engine          = "memcached"
+  node_type       = "cache.t3.micro"
+  num_cache_nodes = 1
+  port            = 11211
+}
+
+data "aws_elasticache_cluster" "test" {
+  cluster_id = aws_elasticache_cluster.test.cluster_id
+}
+`, rName)
+}
+
+func testAccDataSourceAwsElastiCacheClusterConfig_filter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_cluster" "test" {
+  cluster_id      = %[1]q
+  engine          = "memcached"
+  node_type       = "cache.t3.micro"
+  num_cache_nodes = 1
+  port            = 11211
+}
+
+data "aws_elasticache_cluster" "by_filter" {
+  engine = aws_elasticache_cluster.test.engine
+
+  filter {
+    name   = "cluster-id"
+    values = [aws_elasticache_cluster.test.cluster_id]
+  }
+
+  most_recent = true
+}
+`, rName)
+}
+
+func testAccDataSourceAwsElastiCacheClusterConfig_encryptionAndLogDelivery(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_cluster" "test" {
+  cluster_id                 = %[1]q
+  engine                     = "redis"
+  engine_version             = "6.x"
+  node_type                  = "cache.t3.micro"
+  num_cache_nodes            = 1
+  port                       = 6379
+  at_rest_encryption_enabled = true
+  transit_encryption_enabled = true
+  auto_minor_version_upgrade = true
+
+  log_delivery_configuration {
+    destination      = "test-delivery-stream"
+    destination_type = "kinesis-firehose"
+    log_format       = "json"
+    log_type         = "slow-log"
+  }
+}
+
+data "aws_elasticache_cluster" "test" {
+  cluster_id = aws_elasticache_cluster.test.cluster_id
+}
+`, rName)
+}
+
+func testAccDataSourceAwsElastiCacheClusterConfig_filterInvalidName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_cluster" "test" {
+  cluster_id      = %[1]q
+  engine          = "memcached"
+  node_type       = "cache.t3.micro"
+  num_cache_nodes = 1
+  port            = 11211
+}
+
+data "aws_elasticache_cluster" "by_filter" {
+  filter {
+    name   = "node-type"
+    values = [aws_elasticache_cluster.test.node_type]
+  }
+}
+`, rName)
+}
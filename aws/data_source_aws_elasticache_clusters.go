@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsElastiCacheClusters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsElastiCacheClustersRead,
+
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"replication_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"node_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsElastiCacheClustersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	engine, hasEngine := d.GetOk("engine")
+	replicationGroupID, hasReplicationGroupID := d.GetOk("replication_group_id")
+	nodeType, hasNodeType := d.GetOk("node_type")
+	tagsInput, hasTags := d.GetOk("tags")
+
+	req := &elasticache.DescribeCacheClustersInput{}
+
+	var ids []string
+	var arns []string
+
+	log.Printf("[DEBUG] Reading ElastiCache Clusters: %s", req)
+	for {
+		resp, err := conn.DescribeCacheClusters(req)
+		if err != nil {
+			return fmt.Errorf("error describing Elasticache Clusters: %s", err)
+		}
+
+		for _, cluster := range resp.CacheClusters {
+			if hasEngine && aws.StringValue(cluster.Engine) != engine.(string) {
+				continue
+			}
+
+			if hasReplicationGroupID && aws.StringValue(cluster.ReplicationGroupId) != replicationGroupID.(string) {
+				continue
+			}
+
+			if hasNodeType && aws.StringValue(cluster.CacheNodeType) != nodeType.(string) {
+				continue
+			}
+
+			clusterArn := arn.ARN{
+				Partition: meta.(*AWSClient).partition,
+				Service:   "elasticache",
+				Region:    meta.(*AWSClient).region,
+				AccountID: meta.(*AWSClient).accountid,
+				Resource:  fmt.Sprintf("cluster:%s", aws.StringValue(cluster.CacheClusterId)),
+			}.String()
+
+			if hasTags {
+				clusterTags, err := keyvaluetags.ElasticacheListTags(conn, clusterArn)
+				if err != nil {
+					return fmt.Errorf("error listing tags for Elasticache Cluster (%s): %s", clusterArn, err)
+				}
+
+				if !clusterTags.IgnoreAws().ContainsAll(keyvaluetags.New(tagsInput.(map[string]interface{}))) {
+					continue
+				}
+			}
+
+			ids = append(ids, aws.StringValue(cluster.CacheClusterId))
+			arns = append(arns, clusterArn)
+		}
+
+		if resp.Marker == nil || aws.StringValue(resp.Marker) == "" {
+			break
+		}
+		req.Marker = resp.Marker
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+	d.Set("ids", ids)
+	d.Set("arns", arns)
+
+	return nil
+}
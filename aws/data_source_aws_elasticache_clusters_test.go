@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAwsElastiCacheClusters_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_elasticache_clusters.test"
+	resourceName := "aws_elasticache_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsElastiCacheClustersConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "cluster_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsElastiCacheClustersConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_cluster" "test" {
+  cluster_id      = %[1]q
+  engine          = "memcached"
+  node_type       = "cache.t3.micro"
+  num_cache_nodes = 1
+  port            = 11211
+}
+
+data "aws_elasticache_clusters" "test" {
+  engine = aws_elasticache_cluster.test.engine
+
+  depends_on = [aws_elasticache_cluster.test]
+}
+`, rName)
+}
@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsElasticacheReplicationGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsElasticacheReplicationGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"replication_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"replication_group_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"number_cache_clusters": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"member_clusters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"automatic_failover_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"auth_token_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"transit_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"at_rest_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"primary_endpoint_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"configuration_endpoint_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"snapshot_window": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"snapshot_retention_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"cluster_mode": {
+				Type:     schema.TypeList,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"replicas_per_node_group": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"num_node_groups": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"node_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slots": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary_cluster_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replica_cluster_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	groupID := d.Get("replication_group_id").(string)
+
+	req := &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: aws.String(groupID),
+	}
+
+	log.Printf("[DEBUG] Reading ElastiCache Replication Group: %s", req)
+	resp, err := conn.DescribeReplicationGroups(req)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.ReplicationGroups) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+	if len(resp.ReplicationGroups) > 1 {
+		return fmt.Errorf("Your query returned more than one result. Please try a more specific search criteria.")
+	}
+
+	group := resp.ReplicationGroups[0]
+
+	d.SetId(*group.ReplicationGroupId)
+
+	d.Set("replication_group_id", group.ReplicationGroupId)
+	d.Set("replication_group_description", group.Description)
+	d.Set("number_cache_clusters", len(group.MemberClusters))
+	d.Set("member_clusters", flattenStringList(group.MemberClusters))
+	d.Set("automatic_failover_enabled", group.AutomaticFailover != nil && *group.AutomaticFailover == elasticache.AutomaticFailoverStatusEnabled)
+	d.Set("auth_token_enabled", group.AuthTokenEnabled)
+	d.Set("transit_encryption_enabled", group.TransitEncryptionEnabled)
+	d.Set("at_rest_encryption_enabled", group.AtRestEncryptionEnabled)
+	d.Set("snapshot_window", group.SnapshotWindow)
+	d.Set("snapshot_retention_limit", group.SnapshotRetentionLimit)
+
+	if len(group.NodeGroups) > 0 {
+		nodeGroup := group.NodeGroups[0]
+
+		if nodeGroup.PrimaryEndpoint != nil {
+			d.Set("primary_endpoint_address", nodeGroup.PrimaryEndpoint.Address)
+			d.Set("port", nodeGroup.PrimaryEndpoint.Port)
+		}
+	}
+
+	if group.ConfigurationEndpoint != nil {
+		d.Set("configuration_endpoint_address", group.ConfigurationEndpoint.Address)
+		d.Set("port", group.ConfigurationEndpoint.Port)
+	}
+
+	if err := d.Set("cluster_mode", flattenElasticacheReplicationGroupClusterMode(group.NodeGroups)); err != nil {
+		return fmt.Errorf("error setting cluster_mode: %s", err)
+	}
+
+	if err := d.Set("node_groups", flattenElasticacheReplicationGroupNodeGroups(group.NodeGroups)); err != nil {
+		return fmt.Errorf("error setting node_groups: %s", err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "elasticache",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("replicationgroup:%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	tags, err := keyvaluetags.ElasticacheListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Elasticache Replication Group (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func flattenElasticacheReplicationGroupClusterMode(nodeGroups []*elasticache.NodeGroup) []map[string]interface{} {
+	if len(nodeGroups) == 0 {
+		return []map[string]interface{}{}
+	}
+
+	replicasPerNodeGroup := 0
+	if len(nodeGroups[0].NodeGroupMembers) > 0 {
+		replicasPerNodeGroup = len(nodeGroups[0].NodeGroupMembers) - 1
+	}
+
+	m := map[string]interface{}{
+		"replicas_per_node_group": replicasPerNodeGroup,
+		"num_node_groups":         len(nodeGroups),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenElasticacheReplicationGroupNodeGroups(nodeGroups []*elasticache.NodeGroup) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(nodeGroups))
+
+	for _, nodeGroup := range nodeGroups {
+		if nodeGroup == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"node_group_id": aws.StringValue(nodeGroup.NodeGroupId),
+			"slots":         aws.StringValue(nodeGroup.Slots),
+		}
+
+		replicaClusterIds := make([]string, 0, len(nodeGroup.NodeGroupMembers))
+		for _, member := range nodeGroup.NodeGroupMembers {
+			if member == nil || member.CacheClusterId == nil {
+				continue
+			}
+			if member.CurrentRole != nil && *member.CurrentRole == "primary" {
+				m["primary_cluster_id"] = aws.StringValue(member.CacheClusterId)
+				continue
+			}
+			replicaClusterIds = append(replicaClusterIds, aws.StringValue(member.CacheClusterId))
+		}
+		m["replica_cluster_ids"] = replicaClusterIds
+
+		result = append(result, m)
+	}
+
+	return result
+}
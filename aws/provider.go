@@ -0,0 +1,18 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Provider returns a terraform.ResourceProvider.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_elasticache_cluster":           dataSourceAwsElastiCacheCluster(),
+			"aws_elasticache_clusters":          dataSourceAwsElastiCacheClusters(),
+			"aws_elasticache_replication_group": dataSourceAwsElasticacheReplicationGroup(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{},
+	}
+}